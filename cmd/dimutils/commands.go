@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/og-dim9/dimutils/pkg/cbxxml2regex"
 	"github.com/og-dim9/dimutils/pkg/ebcdic"
@@ -17,157 +18,188 @@ import (
 	"github.com/spf13/cobra"
 )
 
-// gitaskopCmd represents the gitaskop command
-var gitaskopCmd = &cobra.Command{
-	Use:                "gitaskop",
-	Short:              "Git task scheduler and runner",
-	Long:               `A git-based task scheduler that runs commands based on repository changes.`,
-	DisableFlagParsing: true,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := gitaskop.Run(args); err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
-		}
-	},
+// tool describes a utility bundled into the multicall binary
+type tool struct {
+	Name  string
+	Short string
+	Long  string
+	// OwnHelp passes -h/--help through to the tool instead of showing cobra help
+	OwnHelp bool
+	Run     func(args []string) error
 }
 
-// eventdiffCmd represents the eventdiff command
-var eventdiffCmd = &cobra.Command{
-	Use:   "eventdiff",
-	Short: "Event difference analyzer",
-	Long:  `Analyze differences between events and data streams.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := eventdiff.Run(args); err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
-		}
+// tools is the registry of all bundled utilities, in the order they are listed
+var tools = []tool{
+	{
+		Name:  "gitaskop",
+		Short: "Git task scheduler and runner",
+		Long:  `A git-based task scheduler that runs commands based on repository changes.`,
+		Run:   gitaskop.Run,
 	},
-}
-
-// unexpectCmd represents the unexpect command
-var unexpectCmd = &cobra.Command{
-	Use:   "unexpect",
-	Short: "Test expectation framework",
-	Long:  `A test framework for setting up expectations and validating outcomes.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := unexpect.Run(args); err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
-		}
+	{
+		Name:  "eventdiff",
+		Short: "Event difference analyzer",
+		Long:  `Analyze differences between events and data streams.`,
+		Run:   eventdiff.Run,
 	},
-}
-
-// serveCmd represents the serve command
-var serveCmd = &cobra.Command{
-	Use:   "serve",
-	Short: "HTTP server utilities",
-	Long:  `Simple HTTP server for development and testing.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := serve.Run(args); err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
-		}
+	{
+		Name:  "unexpect",
+		Short: "Test expectation framework",
+		Long:  `A test framework for setting up expectations and validating outcomes.`,
+		Run:   unexpect.Run,
 	},
-}
-
-// ebcdicCmd represents the ebcdic command
-var ebcdicCmd = &cobra.Command{
-	Use:   "ebcdic",
-	Short: "EBCDIC encoding utilities",
-	Long:  `Tools for working with EBCDIC encoded data.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := ebcdic.Run(args); err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
-		}
+	{
+		Name:  "serve",
+		Short: "HTTP server utilities",
+		Long:  `Simple HTTP server for development and testing.`,
+		Run:   serve.Run,
 	},
-}
-
-// cbxxml2regexCmd represents the cbxxml2regex command
-var cbxxml2regexCmd = &cobra.Command{
-	Use:                "cbxxml2regex",
-	Short:              "COBOL XML to regex converter",
-	Long:               `Convert COBOL XML definitions to regular expressions.`,
-	DisableFlagParsing: true,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := cbxxml2regex.Run(args); err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
-		}
+	{
+		Name:    "ebcdic",
+		Short:   "EBCDIC encoding utilities",
+		Long:    `Tools for working with EBCDIC encoded data.`,
+		OwnHelp: true,
+		Run:     ebcdic.Run,
+	},
+	{
+		Name:    "cbxxml2regex",
+		Short:   "COBOL XML to regex converter",
+		Long:    `Convert COBOL XML definitions to regular expressions.`,
+		OwnHelp: true,
+		Run:     cbxxml2regex.Run,
+	},
+	{
+		Name:    "regex2json",
+		Short:   "Regex to JSON converter",
+		Long:    `Convert regular expression patterns to JSON structures.`,
+		OwnHelp: true,
+		Run:     regex2json.Run,
+	},
+	{
+		Name:  "tandum",
+		Short: "Tandum data processing utility",
+		Long:  `Process and transform tandum-format data.`,
+		Run:   tandum.Run,
+	},
+	{
+		Name:    "mkgchat",
+		Short:   "Make Google Chat utility",
+		Long:    `Utility for creating Google Chat messages and interactions.`,
+		OwnHelp: true,
+		Run:     mkgchat.Run,
+	},
+	{
+		Name:    "togchat",
+		Short:   "To Google Chat utility",
+		Long:    `Send messages and data to Google Chat.`,
+		OwnHelp: true,
+		Run:     togchat.Run,
 	},
 }
 
-// regex2jsonCmd represents the regex2json command
-var regex2jsonCmd = &cobra.Command{
-	Use:   "regex2json",
-	Short: "Regex to JSON converter",
-	Long:  `Convert regular expression patterns to JSON structures.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := regex2json.Run(args); err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
-		}
-	},
+// newToolCommand builds the cobra command for a registered tool. Tools
+// parse their own arguments, so cobra flag parsing is disabled for all of
+// them and the command behaves the same whether invoked as a subcommand or
+// through a symlink.
+func newToolCommand(t tool) *cobra.Command {
+	return &cobra.Command{
+		Use:                t.Name,
+		Short:              t.Short,
+		Long:               t.Long,
+		DisableFlagParsing: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := runIndividualTool(cmd, t, args); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
 }
 
-// tandumCmd represents the tandum command
-var tandumCmd = &cobra.Command{
-	Use:   "tandum",
-	Short: "Tandum data processing utility",
-	Long:  `Process and transform tandum-format data.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := tandum.Run(args); err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
+// runIndividualTool runs a registered tool with its raw arguments, showing
+// the command's help instead for tools that do not handle -h/--help.
+// Cobra does not parse flags for tools, so the global logging flags are
+// picked out and applied here.
+func runIndividualTool(cmd *cobra.Command, t tool, args []string) error {
+	args, found, err := stripLogFlags(args)
+	if err != nil {
+		return err
+	}
+	if found {
+		if err := setupLogging(logLevel, logFormat); err != nil {
+			return err
 		}
-	},
+	}
+	if !t.OwnHelp && wantsHelp(args) {
+		return cmd.Help()
+	}
+	return t.Run(args)
 }
 
-// mkgchatCmd represents the mkgchat command
-var mkgchatCmd = &cobra.Command{
-	Use:                "mkgchat",
-	Short:              "Make Google Chat utility",
-	Long:               `Utility for creating Google Chat messages and interactions.`,
-	DisableFlagParsing: true,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := mkgchat.Run(args); err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
+// stripLogFlags removes --log-level and --log-format from args before any
+// "--" terminator, storing their values in logLevel and logFormat. It
+// reports whether either flag was found.
+func stripLogFlags(args []string) ([]string, bool, error) {
+	flags := map[string]*string{
+		"--log-level":  &logLevel,
+		"--log-format": &logFormat,
+	}
+
+	var rest []string
+	found := false
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			return append(rest, args[i:]...), found, nil
 		}
-	},
-}
 
-// togchatCmd represents the togchat command
-var togchatCmd = &cobra.Command{
-	Use:                "togchat",
-	Short:              "To Google Chat utility",
-	Long:               `Send messages and data to Google Chat.`,
-	DisableFlagParsing: true,
-	Run: func(cmd *cobra.Command, args []string) {
-		if err := togchat.Run(args); err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			os.Exit(1)
+		name, value, hasValue := strings.Cut(arg, "=")
+		target, ok := flags[name]
+		if !ok {
+			rest = append(rest, arg)
+			continue
 		}
-	},
+		if !hasValue {
+			if i+1 >= len(args) {
+				return nil, false, fmt.Errorf("flag needs an argument: %s", name)
+			}
+			i++
+			value = args[i]
+		}
+		*target = value
+		found = true
+	}
+	return rest, found, nil
 }
 
-// runIndividualTool shows a placeholder message for now
-func runIndividualTool(toolName string, args []string) {
-	cobra.CheckErr(fmt.Errorf("%s tool not yet integrated into multicall binary. Please use individual binary from src/%s/ or run 'make %s' to build it", toolName, toolName, toolName))
+// wantsHelp reports whether args request help before any "--" terminator
+func wantsHelp(args []string) bool {
+	for _, arg := range args {
+		switch arg {
+		case "--":
+			return false
+		case "-h", "--help":
+			return true
+		}
+	}
+	return false
 }
 
 func init() {
 	// Add all tool commands to root
-	rootCmd.AddCommand(
-		gitaskopCmd,
-		eventdiffCmd,
-		unexpectCmd,
-		serveCmd,
-		ebcdicCmd,
-		cbxxml2regexCmd,
-		regex2jsonCmd,
-		tandumCmd,
-		mkgchatCmd,
-		togchatCmd,
-	)
-}
\ No newline at end of file
+	for _, t := range tools {
+		rootCmd.AddCommand(newToolCommand(t))
+	}
+
+	// Hidden command listing tool names, used by the Makefile for symlinks
+	rootCmd.AddCommand(&cobra.Command{
+		Use:    "list-tools",
+		Short:  "List the bundled tool names",
+		Hidden: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			for _, t := range tools {
+				fmt.Println(t.Name)
+			}
+		},
+	})
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStripLogFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		rest    []string
+		found   bool
+		wantErr bool
+		level   string
+		format  string
+	}{
+		{
+			name:   "no flags",
+			args:   []string{"run", "--name", "x"},
+			rest:   []string{"run", "--name", "x"},
+			level:  "info",
+			format: "text",
+		},
+		{
+			name:   "separate value",
+			args:   []string{"--log-level", "debug", "run"},
+			rest:   []string{"run"},
+			found:  true,
+			level:  "debug",
+			format: "text",
+		},
+		{
+			name:   "equals value",
+			args:   []string{"run", "--log-format=json"},
+			rest:   []string{"run"},
+			found:  true,
+			level:  "info",
+			format: "json",
+		},
+		{
+			name:   "after terminator",
+			args:   []string{"run", "--", "--log-level", "debug"},
+			rest:   []string{"run", "--", "--log-level", "debug"},
+			level:  "info",
+			format: "text",
+		},
+		{
+			name:    "missing value",
+			args:    []string{"run", "--log-level"},
+			wantErr: true,
+			level:   "info",
+			format:  "text",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logLevel, logFormat = "info", "text"
+			rest, found, err := stripLogFlags(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(rest, tt.rest) {
+				t.Errorf("rest = %q, want %q", rest, tt.rest)
+			}
+			if found != tt.found {
+				t.Errorf("found = %v, want %v", found, tt.found)
+			}
+			if logLevel != tt.level || logFormat != tt.format {
+				t.Errorf("level/format = %s/%s, want %s/%s", logLevel, logFormat, tt.level, tt.format)
+			}
+		})
+	}
+	logLevel, logFormat = "info", "text"
+}
+
+func TestWantsHelp(t *testing.T) {
+	tests := []struct {
+		args []string
+		want bool
+	}{
+		{[]string{}, false},
+		{[]string{"-h"}, true},
+		{[]string{"--port", "80", "--help"}, true},
+		{[]string{"--help=true"}, false},
+		{[]string{"--", "-h"}, false},
+	}
+
+	for _, tt := range tests {
+		if got := wantsHelp(tt.args); got != tt.want {
+			t.Errorf("wantsHelp(%q) = %v, want %v", tt.args, got, tt.want)
+		}
+	}
+}
@@ -2,17 +2,39 @@ package main
 
 import (
 	"fmt"
+	"log"
+	"log/slog"
 	"os"
 	"path/filepath"
+	"runtime/debug"
 	"strings"
 
 	"github.com/spf13/cobra"
 )
 
+// Build information, injected via -ldflags at build time
+var (
+	Version   = "0.3.0"
+	Commit    = "unknown"
+	BuildDate = "unknown"
+)
+
+// Logging options, set by the persistent --log-level and --log-format flags
+var (
+	logLevel  = "info"
+	logFormat = "text"
+)
+
 var rootCmd = &cobra.Command{
 	Use:   "dimutils",
 	Short: "Dim9 utilities multicall binary",
 	Long:  `A multicall binary containing various data processing and transformation utilities.`,
+	// main reports errors itself
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		return setupLogging(logLevel, logFormat)
+	},
 }
 
 func main() {
@@ -59,13 +81,73 @@ func contains(slice []string, item string) bool {
 	return false
 }
 
+// setupLogging installs the default slog logger, writing to stderr so
+// that program output on stdout stays clean
+func setupLogging(level, format string) error {
+	var lvl slog.Level
+	if err := lvl.UnmarshalText([]byte(level)); err != nil {
+		return fmt.Errorf("invalid log level %q (use debug, info, warn or error)", level)
+	}
+
+	opts := &slog.HandlerOptions{Level: lvl}
+	switch format {
+	case "text":
+		slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, opts)))
+	case "json":
+		slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stderr, opts)))
+	default:
+		return fmt.Errorf("invalid log format %q (use text or json)", format)
+	}
+
+	// SetDefault routes the standard log package through slog as well;
+	// keep its original output for the tools that still use it
+	log.SetOutput(os.Stderr)
+	log.SetFlags(log.LstdFlags)
+	return nil
+}
+
+// fillBuildInfo falls back to the VCS stamp embedded by go build when the
+// commit or build date were not injected via -ldflags
+func fillBuildInfo() {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return
+	}
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			if Commit == "unknown" && len(setting.Value) >= 7 {
+				Commit = setting.Value[:7]
+			}
+		case "vcs.time":
+			if BuildDate == "unknown" {
+				BuildDate = setting.Value
+			}
+		}
+	}
+}
+
+// versionString formats the build information for display
+func versionString() string {
+	return fmt.Sprintf("dimutils version %s (commit %s, built %s)", Version, Commit, BuildDate)
+}
+
 func init() {
+	// Global logging flags
+	rootCmd.PersistentFlags().StringVar(&logLevel, "log-level", logLevel, "log level (debug, info, warn, error)")
+	rootCmd.PersistentFlags().StringVar(&logFormat, "log-format", logFormat, "log format (text, json)")
+
+	// Global --version flag
+	fillBuildInfo()
+	rootCmd.Version = Version
+	rootCmd.SetVersionTemplate(versionString() + "\n")
+
 	// Version command
 	rootCmd.AddCommand(&cobra.Command{
 		Use:   "version",
 		Short: "Show version information",
 		Run: func(cmd *cobra.Command, args []string) {
-			fmt.Println("dimutils version 0.3.0")
+			fmt.Println(versionString())
 		},
 	})
 }
\ No newline at end of file
@@ -3,6 +3,7 @@ package eventdiff
 import (
 	"bufio"
 	"fmt"
+	"log/slog"
 	"os"
 	"strings"
 )
@@ -68,10 +69,12 @@ func (ed *EventDiff) Process() error {
 
 	scanner := bufio.NewScanner(os.Stdin)
 
+	lineNum := 0
 	for scanner.Scan() {
 		line := scanner.Text()
+		lineNum++
 		if !strings.Contains(line, "|") {
-			fmt.Fprintln(os.Stderr, "No pipe found")
+			slog.Warn("no pipe found", "line_number", lineNum)
 			continue
 		}
 
@@ -81,7 +84,7 @@ func (ed *EventDiff) Process() error {
 
 		diff, err := ed.ifDiff(key, value)
 		if err != nil {
-			fmt.Fprintln(os.Stderr, "error:", err)
+			slog.Error("error comparing event", "key", key, "error", err)
 		}
 
 		if diff {
@@ -90,7 +93,7 @@ func (ed *EventDiff) Process() error {
 	}
 
 	if err := scanner.Err(); err != nil {
-		fmt.Fprintln(os.Stderr, "error:", err)
+		slog.Error("error reading input", "error", err)
 		return err
 	}
 	
@@ -4,6 +4,7 @@ import (
 	"crypto/md5"
 	"encoding/hex"
 	"fmt"
+	"log/slog"
 	"os"
 	"os/exec"
 	"os/signal"
@@ -33,7 +34,7 @@ func Run(args []string) error {
 	
 	go func() {
 		<-c
-		fmt.Println("\nReceived interrupt signal, shutting down...")
+		slog.Info("received interrupt signal, shutting down")
 		config.Running = false
 		wg.Wait()
 		os.Exit(0)
@@ -41,7 +42,7 @@ func Run(args []string) error {
 
 	// Start each command in a goroutine
 	for _, arg := range args {
-		fmt.Println("Starting:", arg)
+		slog.Info("starting command", "command", arg)
 		wg.Add(1)
 		go func(command string) {
 			defer wg.Done()
@@ -67,7 +68,7 @@ func keepRunning(arg string, config *Config) {
 	for config.Running {
 		logfile, err := os.OpenFile(logFilename(arg), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error opening log file: %v\n", err)
+			slog.Error("error opening log file", "command", arg, "error", err)
 			return
 		}
 
@@ -78,7 +79,7 @@ func keepRunning(arg string, config *Config) {
 
 		err = cmd.Start()
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error starting command: %v\n", err)
+			slog.Error("error starting command", "command", arg, "error", err)
 			logfile.Close()
 			return
 		}
@@ -87,10 +88,10 @@ func keepRunning(arg string, config *Config) {
 		logfile.Close()
 		
 		if err != nil {
-			fmt.Printf("Command failed: %v\n", err)
+			slog.Warn("command failed", "command", arg, "error", err)
 
 			if time.Since(started) < 10*time.Second && retries < 3 {
-				fmt.Fprintln(os.Stderr, "Command failed immediately, not retrying")
+				slog.Error("command failed immediately, not retrying", "command", arg)
 				return
 			}
 
@@ -98,7 +99,7 @@ func keepRunning(arg string, config *Config) {
 				return
 			}
 
-			fmt.Printf("Retrying in %d seconds\n", backoff)
+			slog.Info("retrying command", "command", arg, "backoff_seconds", backoff)
 			time.Sleep(time.Duration(backoff) * time.Second)
 			backoff = min(60, backoff*2)
 		} else {